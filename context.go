@@ -0,0 +1,78 @@
+package logger
+
+import "context"
+
+type contextKey int
+
+const (
+	instanceContextKey contextKey = iota
+	fieldsContextKey
+)
+
+// WithContext returns a copy of ctx carrying li, retrievable with
+// FromContext. Use this to thread a request-scoped Instance through
+// middleware and handlers without passing it explicitly.
+func WithContext(ctx context.Context, li *Instance) context.Context {
+	return context.WithValue(ctx, instanceContextKey, li)
+}
+
+// FromContext returns the Instance stored in ctx by WithContext, or def if
+// none is present.
+func FromContext(ctx context.Context, def *Instance) *Instance {
+	if li, ok := ctx.Value(instanceContextKey).(*Instance); ok {
+		return li
+	}
+
+	return def
+}
+
+// WithFields returns a copy of ctx with fields merged into any fields
+// already attached to it. Fields attached this way are automatically
+// included by every *Ctx log call, so middleware can attach request
+// metadata (trace ID, user ID, request ID) once and have it carried by
+// every downstream log line.
+func WithFields(ctx context.Context, fields ...LogFields) context.Context {
+	existing, _ := ctx.Value(fieldsContextKey).([]LogFields)
+
+	merged := make([]LogFields, 0, len(existing)+len(fields))
+	merged = append(merged, existing...)
+	merged = append(merged, fields...)
+
+	return context.WithValue(ctx, fieldsContextKey, merged)
+}
+
+// fieldsFromContext returns the fields attached to ctx via WithFields.
+func fieldsFromContext(ctx context.Context) []LogFields {
+	fields, _ := ctx.Value(fieldsContextKey).([]LogFields)
+	return fields
+}
+
+// DebugCtx logs at debug level, merging fields attached to ctx via
+// WithFields with the fields passed here.
+func (li *Instance) DebugCtx(ctx context.Context, msg string, fields ...LogFields) {
+	li.Debug(msg, append(fieldsFromContext(ctx), fields...)...)
+}
+
+// InfoCtx logs at info level, merging fields attached to ctx via
+// WithFields with the fields passed here.
+func (li *Instance) InfoCtx(ctx context.Context, msg string, fields ...LogFields) {
+	li.Info(msg, append(fieldsFromContext(ctx), fields...)...)
+}
+
+// ErrorCtx logs at error level, merging fields attached to ctx via
+// WithFields with the fields passed here.
+func (li *Instance) ErrorCtx(ctx context.Context, msg string, fields ...LogFields) {
+	li.Error(msg, append(fieldsFromContext(ctx), fields...)...)
+}
+
+// FatalCtx logs at fatal level, merging fields attached to ctx via
+// WithFields with the fields passed here.
+func (li *Instance) FatalCtx(ctx context.Context, msg string, fields ...LogFields) {
+	li.Fatal(msg, append(fieldsFromContext(ctx), fields...)...)
+}
+
+// PanicCtx logs at panic level, merging fields attached to ctx via
+// WithFields with the fields passed here.
+func (li *Instance) PanicCtx(ctx context.Context, msg string, fields ...LogFields) {
+	li.Panic(msg, append(fieldsFromContext(ctx), fields...)...)
+}
@@ -0,0 +1,33 @@
+package logger
+
+import (
+	"net/http"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// SetLevel changes the minimum level the Instance logs at without a
+// restart. level is one of "debug", "info", "warn", "error", "dpanic",
+// "panic", or "fatal".
+func (li *Instance) SetLevel(level string) error {
+	parsed, err := zapcore.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+
+	li.level.SetLevel(parsed)
+	return nil
+}
+
+// Level returns the Instance's current minimum level.
+func (li *Instance) Level() string {
+	return li.level.String()
+}
+
+// LevelHandler returns an http.Handler implementing the zap-standard
+// level protocol: GET returns the current level as {"level":"info"}, and
+// PUT with the same body sets it. Suitable for mounting at
+// /debug/log/level.
+func (li *Instance) LevelHandler() http.Handler {
+	return li.level
+}
@@ -1,18 +1,27 @@
 package logger
 
 import (
-	"fmt"
+	"context"
+	"os"
+	"reflect"
+	"time"
 
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 // Logger interface
 type Logger interface {
 	Debug(msg string, fields ...LogFields)
+	DebugCtx(ctx context.Context, msg string, fields ...LogFields)
 	Error(msg string, fields ...LogFields)
+	ErrorCtx(ctx context.Context, msg string, fields ...LogFields)
 	Fatal(msg string, fields ...LogFields)
+	FatalCtx(ctx context.Context, msg string, fields ...LogFields)
 	Info(msg string, fields ...LogFields)
+	InfoCtx(ctx context.Context, msg string, fields ...LogFields)
 	Panic(msg string, fields ...LogFields)
+	PanicCtx(ctx context.Context, msg string, fields ...LogFields)
 }
 
 // LogFields to define custom log field key and values
@@ -21,9 +30,12 @@ type LogFields struct {
 	Value interface{}
 }
 
+var _ Logger = (*Instance)(nil)
+
 // Instance of the logger
 type Instance struct {
 	logger *zap.Logger
+	level  zap.AtomicLevel
 }
 
 // Config object to configure the logger
@@ -36,39 +48,117 @@ type Config struct {
 	StackTraceKey string
 	TimestampKey  string
 	CallerSkip    int
+
+	// FilePaths are additional destinations written to alongside the
+	// default stdout/stderr output. Each path gets its own zapcore.Core,
+	// combined with the rest via a tee core.
+	FilePaths []string
+
+	// Rotation controls rotation for every path in FilePaths. When nil,
+	// files still go through lumberjack with its defaults: rotation at
+	// 100 MB, with old files neither removed nor compressed.
+	Rotation *RotationPolicy
+
+	// InitialLevel is the level New starts at ("debug", "info", "warn",
+	// "error", "dpanic", "panic", "fatal"). Defaults to "info". The level
+	// can be changed at runtime via Instance.SetLevel or LevelHandler.
+	InitialLevel string
+
+	// Sampling, when set, drops log lines past a configurable threshold
+	// so high-throughput services don't overwhelm downstream log
+	// pipelines. A nil Sampling disables sampling entirely; zap's own
+	// production default sampler is not used either.
+	Sampling *SamplingPolicy
 }
 
 // Default returns a default non-JSON logger
 func Default() (*Instance, error) {
-	l, err := zap.NewDevelopment()
+	config := zap.NewDevelopmentConfig()
+
+	l, err := config.Build()
 	if err != nil {
 		return nil, err
 	}
 
 	return &Instance{
 		logger: l,
+		level:  config.Level,
 	}, nil
 }
 
-// New returns a configurable JSON logger
-func New(userConfig Config) (*Instance, error) {
+// New returns a configurable JSON logger. By default it writes to stderr;
+// pass userConfig.FilePaths to also write to one or more (optionally
+// rotating) files, or opts to plug in arbitrary writers.
+func New(userConfig Config, opts ...Option) (*Instance, error) {
 	config := zap.NewProductionConfig()
 	applyConfig(&config, userConfig)
 
-	l, err := config.Build()
-	if err != nil {
-		return nil, err
+	// Sampling is applied ourselves via SamplingPolicy below, uniformly
+	// across the single-core and tee-core paths, so zap's own default
+	// sampler is turned off here to avoid sampling twice.
+	config.Sampling = nil
+
+	if userConfig.InitialLevel != "" {
+		level, err := zapcore.ParseLevel(userConfig.InitialLevel)
+		if err != nil {
+			return nil, err
+		}
+		config.Level.SetLevel(level)
+	}
+
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	var l *zap.Logger
+	if len(userConfig.FilePaths) == 0 && len(o.writers) == 0 {
+		built, err := config.Build()
+		if err != nil {
+			return nil, err
+		}
+		l = built
+	} else {
+		enc := zapcore.NewJSONEncoder(config.EncoderConfig)
+		level := config.Level
+
+		cores := []zapcore.Core{writerCore(zapcore.Lock(os.Stderr), enc, level)}
+		for _, path := range userConfig.FilePaths {
+			cores = append(cores, fileCore(path, userConfig.Rotation, enc, level))
+		}
+		for _, w := range o.writers {
+			cores = append(cores, writerCore(w, enc, level))
+		}
+
+		l = zap.New(zapcore.NewTee(cores...), zap.AddCaller(), zap.AddStacktrace(zapcore.ErrorLevel))
 	}
 
+	l = l.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		// dedupedCore must be innermost: zapcore's sampler makes its
+		// keep/drop decision in Check and only delegates to the wrapped
+		// core's Check when it keeps an entry, so the sampler has to sit
+		// outside the dedupe core or its per-tick sampling never runs.
+		core = dedupedCore(core, userConfig.Sampling)
+		return sampledCore(core, userConfig.Sampling)
+	}))
+
+	return newInstance(l, userConfig, config.Level), nil
+}
+
+func newInstance(l *zap.Logger, userConfig Config, level zap.AtomicLevel) *Instance {
 	if userConfig.CallerSkip != 0 {
-		return &Instance{
-			logger: l.WithOptions(zap.AddCallerSkip(userConfig.CallerSkip)),
-		}, nil
+		l = l.WithOptions(zap.AddCallerSkip(userConfig.CallerSkip))
 	}
 
-	return &Instance{
-		logger: l,
-	}, nil
+	return &Instance{logger: l, level: level}
+}
+
+// With returns a child Instance that carries fields on every subsequent
+// log call, including plain (non-Ctx) ones. Use this to bind per-request
+// data (trace ID, user ID, request ID) onto an Instance handed out for
+// the lifetime of that request.
+func (li *Instance) With(fields ...LogFields) *Instance {
+	return &Instance{logger: li.logger.With(grabFields(fields)...), level: li.level}
 }
 
 // Debug logs
@@ -102,45 +192,55 @@ func (li *Instance) Panic(msg string, fields ...LogFields) {
 }
 
 func grabFields(fields []LogFields) []zap.Field {
-	args := make([]zap.Field, 0)
+	args := make([]zap.Field, 0, len(fields))
 	for _, field := range fields {
-		key := field.Key
-		switch v := field.Value.(type) {
-		case int:
-			args = append(args, zap.Int(key, v))
-		case string:
-			args = append(args, zap.String(key, v))
-		case bool:
-			args = append(args, zap.Bool(key, v))
-		case float64:
-			args = append(args, zap.Float64(key, v))
-		case interface{}:
-			args = append(args, zap.Any(key, v))
-		case []string:
-			for i, val := range v {
-				args = append(args, zap.String(key+fmt.Sprint(i), val))
-			}
-		case []int:
-			for i, val := range v {
-				args = append(args, zap.Int(key+fmt.Sprint(i), val))
-			}
-		case []bool:
-			for i, val := range v {
-				args = append(args, zap.Bool(key+fmt.Sprint(i), val))
-			}
-		case []float64:
-			for i, val := range v {
-				args = append(args, zap.Float64(key+fmt.Sprint(i), val))
-			}
-		case []interface{}:
-			for i, val := range v {
-				args = append(args, zap.Any(key+fmt.Sprint(i), val))
-			}
-		}
+		args = append(args, fieldFor(field.Key, field.Value))
 	}
 	return args
 }
 
+// fieldFor dispatches a LogFields value to the matching zap constructor.
+// Typed fast paths come first; slices are encoded as a single field
+// (rather than expanded into key0, key1, ...) so JSON consumers can
+// aggregate on them, and anything else falls back to zap.Any.
+func fieldFor(key string, value interface{}) zap.Field {
+	switch v := value.(type) {
+	case int:
+		return zap.Int(key, v)
+	case int64:
+		return zap.Int64(key, v)
+	case uint64:
+		return zap.Uint64(key, v)
+	case float64:
+		return zap.Float64(key, v)
+	case bool:
+		return zap.Bool(key, v)
+	case string:
+		return zap.String(key, v)
+	case time.Time:
+		return zap.Time(key, v)
+	case time.Duration:
+		return zap.Duration(key, v)
+	case error:
+		return zap.NamedError(key, v)
+	case []byte:
+		return zap.Binary(key, v)
+	case []string:
+		return zap.Strings(key, v)
+	case []int:
+		return zap.Ints(key, v)
+	case []bool:
+		return zap.Bools(key, v)
+	case []float64:
+		return zap.Float64s(key, v)
+	default:
+		if reflect.ValueOf(value).Kind() == reflect.Slice {
+			return zap.Reflect(key, v)
+		}
+		return zap.Any(key, v)
+	}
+}
+
 func applyConfig(config *zap.Config, userConfig Config) {
 	if userConfig.CallerKey != "" {
 		config.EncoderConfig.CallerKey = userConfig.CallerKey
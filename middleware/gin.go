@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/Tak1za/go-logger"
+	"github.com/gin-gonic/gin"
+)
+
+// Gin returns a gin.HandlerFunc that logs a start+finish pair for every
+// request (method, path, status, latency, response size, remote IP, and
+// user-agent) and injects a per-request *logger.Instance, carrying an
+// auto-generated or propagated X-Request-ID, into the request context.
+func Gin(li *logger.Instance, opts ...Option) gin.HandlerFunc {
+	cfg := newConfig(opts)
+
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" {
+			requestID = newRequestID()
+		}
+		c.Writer.Header().Set(RequestIDHeader, requestID)
+
+		reqLogger := li.With(logger.LogFields{Key: "requestId", Value: requestID})
+		ctx := logger.WithFields(c.Request.Context(), logger.LogFields{Key: "requestId", Value: requestID})
+		ctx = logger.WithContext(ctx, reqLogger)
+		c.Request = c.Request.WithContext(ctx)
+
+		start := time.Now()
+		logAt(reqLogger, cfg.startLevel, "request started",
+			logger.LogFields{Key: "method", Value: c.Request.Method},
+			logger.LogFields{Key: "path", Value: c.Request.URL.Path},
+			logger.LogFields{Key: "remoteIp", Value: c.ClientIP()},
+			logger.LogFields{Key: "userAgent", Value: c.Request.UserAgent()},
+		)
+
+		c.Next()
+
+		logAt(reqLogger, cfg.finishLevel, "request finished",
+			logger.LogFields{Key: "method", Value: c.Request.Method},
+			logger.LogFields{Key: "path", Value: c.Request.URL.Path},
+			logger.LogFields{Key: "status", Value: c.Writer.Status()},
+			logger.LogFields{Key: "latencyMs", Value: time.Since(start).Milliseconds()},
+			logger.LogFields{Key: "responseSize", Value: c.Writer.Size()},
+			logger.LogFields{Key: "remoteIp", Value: c.ClientIP()},
+			logger.LogFields{Key: "userAgent", Value: c.Request.UserAgent()},
+		)
+	}
+}
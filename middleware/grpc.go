@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/Tak1za/go-logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCUnary returns a grpc.UnaryServerInterceptor that logs a start+finish
+// pair for every call (method, status, latency, remote IP, and
+// user-agent) and injects a per-request *logger.Instance, carrying an
+// auto-generated or propagated x-request-id, into the handler context.
+func GRPCUnary(li *logger.Instance, opts ...Option) grpc.UnaryServerInterceptor {
+	cfg := newConfig(opts)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		requestID := requestIDFromMetadata(ctx)
+		if requestID == "" {
+			requestID = newRequestID()
+		}
+
+		reqLogger := li.With(logger.LogFields{Key: "requestId", Value: requestID})
+		ctx = logger.WithFields(ctx, logger.LogFields{Key: "requestId", Value: requestID})
+		ctx = logger.WithContext(ctx, reqLogger)
+
+		remoteIP := "unknown"
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			remoteIP = p.Addr.String()
+		}
+
+		start := time.Now()
+		logAt(reqLogger, cfg.startLevel, "request started",
+			logger.LogFields{Key: "method", Value: info.FullMethod},
+			logger.LogFields{Key: "remoteIp", Value: remoteIP},
+			logger.LogFields{Key: "userAgent", Value: userAgentFromMetadata(ctx)},
+		)
+
+		resp, err := handler(ctx, req)
+
+		logAt(reqLogger, cfg.finishLevel, "request finished",
+			logger.LogFields{Key: "method", Value: info.FullMethod},
+			logger.LogFields{Key: "status", Value: status.Code(err).String()},
+			logger.LogFields{Key: "latencyMs", Value: time.Since(start).Milliseconds()},
+			logger.LogFields{Key: "remoteIp", Value: remoteIP},
+			logger.LogFields{Key: "userAgent", Value: userAgentFromMetadata(ctx)},
+		)
+
+		return resp, err
+	}
+}
+
+func requestIDFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get("x-request-id")
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+func userAgentFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get("user-agent")
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/Tak1za/go-logger"
+)
+
+// responseRecorder wraps http.ResponseWriter to capture the status code
+// and response size written by the wrapped handler. It forwards
+// Flusher/Hijacker/Pusher to the wrapped writer when supported, so
+// streaming, WebSocket upgrades, and HTTP/2 push still work for handlers
+// behind this middleware.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	size   int
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+
+	n, err := r.ResponseWriter.Write(b)
+	r.size += n
+
+	return n, err
+}
+
+func (r *responseRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (r *responseRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("middleware: underlying ResponseWriter does not support http.Hijacker")
+	}
+
+	return h.Hijack()
+}
+
+func (r *responseRecorder) Push(target string, opts *http.PushOptions) error {
+	p, ok := r.ResponseWriter.(http.Pusher)
+	if !ok {
+		return http.ErrNotSupported
+	}
+
+	return p.Push(target, opts)
+}
+
+// HTTP returns net/http middleware that logs a start+finish pair for
+// every request (method, path, status, latency, response size, remote IP,
+// and user-agent) and injects a per-request *logger.Instance, carrying an
+// auto-generated or propagated X-Request-ID, into the request context.
+func HTTP(li *logger.Instance, opts ...Option) func(http.Handler) http.Handler {
+	cfg := newConfig(opts)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(RequestIDHeader)
+			if requestID == "" {
+				requestID = newRequestID()
+			}
+			w.Header().Set(RequestIDHeader, requestID)
+
+			reqLogger := li.With(logger.LogFields{Key: "requestId", Value: requestID})
+			ctx := logger.WithFields(r.Context(), logger.LogFields{Key: "requestId", Value: requestID})
+			ctx = logger.WithContext(ctx, reqLogger)
+			r = r.WithContext(ctx)
+
+			start := time.Now()
+			logAt(reqLogger, cfg.startLevel, "request started",
+				logger.LogFields{Key: "method", Value: r.Method},
+				logger.LogFields{Key: "path", Value: r.URL.Path},
+				logger.LogFields{Key: "remoteIp", Value: r.RemoteAddr},
+				logger.LogFields{Key: "userAgent", Value: r.UserAgent()},
+			)
+
+			rec := &responseRecorder{ResponseWriter: w}
+			next.ServeHTTP(rec, r)
+
+			logAt(reqLogger, cfg.finishLevel, "request finished",
+				logger.LogFields{Key: "method", Value: r.Method},
+				logger.LogFields{Key: "path", Value: r.URL.Path},
+				logger.LogFields{Key: "status", Value: rec.status},
+				logger.LogFields{Key: "latencyMs", Value: time.Since(start).Milliseconds()},
+				logger.LogFields{Key: "responseSize", Value: rec.size},
+				logger.LogFields{Key: "remoteIp", Value: r.RemoteAddr},
+				logger.LogFields{Key: "userAgent", Value: r.UserAgent()},
+			)
+		})
+	}
+}
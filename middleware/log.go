@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"github.com/Tak1za/go-logger"
+)
+
+// logAt logs msg at the named level, falling back to Info for an unknown
+// level name.
+func logAt(li *logger.Instance, level, msg string, fields ...logger.LogFields) {
+	switch level {
+	case "debug":
+		li.Debug(msg, fields...)
+	case "error":
+		li.Error(msg, fields...)
+	case "fatal":
+		li.Fatal(msg, fields...)
+	case "panic":
+		li.Panic(msg, fields...)
+	default:
+		li.Info(msg, fields...)
+	}
+}
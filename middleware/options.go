@@ -0,0 +1,32 @@
+package middleware
+
+// config controls the log levels emitted by the adapters in this package.
+// Levels name one of the Instance log methods this package dispatches to:
+// "debug", "info", "error", "fatal", or "panic". logger.Instance has no
+// Warn method, so "warn" is not accepted; an unrecognized level falls
+// back to "info".
+type config struct {
+	startLevel  string
+	finishLevel string
+}
+
+// Option configures an access-log adapter.
+type Option func(*config)
+
+// WithLevels sets the level used for the request-start and request-finish
+// log lines. Both default to "info".
+func WithLevels(start, finish string) Option {
+	return func(c *config) {
+		c.startLevel = start
+		c.finishLevel = finish
+	}
+}
+
+func newConfig(opts []Option) config {
+	c := config{startLevel: "info", finishLevel: "info"}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
+}
@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// RequestIDHeader is the header used to propagate or set the per-request
+// ID injected by the adapters in this package.
+const RequestIDHeader = "X-Request-ID"
+
+// newRequestID returns a random 16-byte hex-encoded ID, used when an
+// incoming request carries none.
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,146 @@
+package logger
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// SamplingPolicy caps the volume of repetitive log lines a Core emits, so
+// noisy Info/Debug lines get dropped after a threshold per Tick instead of
+// overwhelming downstream log pipelines.
+type SamplingPolicy struct {
+	// Initial and Thereafter are passed straight through to
+	// zapcore.NewSamplerWithOptions: the first Initial log lines with a
+	// given level+message within a Tick are always logged, after which
+	// only every Thereafter-th one is.
+	Initial    int
+	Thereafter int
+	Tick       time.Duration
+
+	// DuplicateLimit, when non-zero, caps how many fully identical log
+	// lines (same message and same fields) are emitted per Tick. Unlike
+	// Initial/Thereafter, which key on level+message only, this drops
+	// repeats of the exact same line more aggressively than lines that
+	// merely share a message but differ in fields.
+	DuplicateLimit int
+}
+
+func (p *SamplingPolicy) tick() time.Duration {
+	if p == nil || p.Tick <= 0 {
+		return time.Second
+	}
+	return p.Tick
+}
+
+// sampledCore wraps core with zapcore's standard level+message sampler.
+func sampledCore(core zapcore.Core, policy *SamplingPolicy) zapcore.Core {
+	if policy == nil || (policy.Initial == 0 && policy.Thereafter == 0) {
+		return core
+	}
+
+	return zapcore.NewSamplerWithOptions(core, policy.tick(), policy.Initial, policy.Thereafter)
+}
+
+// dedupeState is the counting state shared by every dedupeCore derived
+// from the same root via With, so sibling loggers still share one
+// duplicate budget per Tick.
+type dedupeState struct {
+	mu          sync.Mutex
+	counts      map[string]int
+	windowStart time.Time
+}
+
+// dedupeCore drops fully identical (message + field) log lines beyond
+// policy.DuplicateLimit within a Tick window.
+type dedupeCore struct {
+	zapcore.Core
+	state  *dedupeState
+	window time.Duration
+	limit  int
+
+	// with holds the fields bound via With, so they count toward a
+	// line's identity alongside the per-call fields.
+	with []zapcore.Field
+}
+
+func dedupedCore(core zapcore.Core, policy *SamplingPolicy) zapcore.Core {
+	if policy == nil || policy.DuplicateLimit == 0 {
+		return core
+	}
+
+	return &dedupeCore{
+		Core:   core,
+		state:  &dedupeState{counts: make(map[string]int)},
+		window: policy.tick(),
+		limit:  policy.DuplicateLimit,
+	}
+}
+
+func (c *dedupeCore) With(fields []zapcore.Field) zapcore.Core {
+	with := make([]zapcore.Field, 0, len(c.with)+len(fields))
+	with = append(append(with, c.with...), fields...)
+
+	return &dedupeCore{
+		Core:   c.Core.With(fields),
+		state:  c.state,
+		window: c.window,
+		limit:  c.limit,
+		with:   with,
+	}
+}
+
+func (c *dedupeCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *dedupeCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	if !c.allow(ent, fields) {
+		return nil
+	}
+	return c.Core.Write(ent, fields)
+}
+
+func (c *dedupeCore) allow(ent zapcore.Entry, fields []zapcore.Field) bool {
+	key := dedupeKey(ent, append(c.with[:len(c.with):len(c.with)], fields...))
+
+	c.state.mu.Lock()
+	defer c.state.mu.Unlock()
+
+	if ent.Time.Sub(c.state.windowStart) > c.window {
+		c.state.windowStart = ent.Time
+		c.state.counts = make(map[string]int)
+	}
+
+	c.state.counts[key]++
+	return c.state.counts[key] <= c.limit
+}
+
+// dedupeKey identifies a log line by its level, message, and field
+// values, so that two calls with the same message but different fields
+// are treated as distinct lines.
+func dedupeKey(ent zapcore.Entry, fields []zapcore.Field) string {
+	enc := zapcore.NewMapObjectEncoder()
+	for _, f := range fields {
+		f.AddTo(enc)
+	}
+
+	keys := make([]string, 0, len(enc.Fields))
+	for k := range enc.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	key := ent.Level.String() + "|" + ent.Message
+	for _, k := range keys {
+		key += fmt.Sprintf("|%s=%v", k, enc.Fields[k])
+	}
+
+	return key
+}
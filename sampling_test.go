@@ -0,0 +1,38 @@
+package logger
+
+import (
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// TestSamplingAndDedupeCompose verifies that wiring both a SamplingPolicy's
+// Initial/Thereafter sampler and its DuplicateLimit dedupe core does not
+// let the dedupe core silently disable the sampler: the level+message
+// sampler must still run even when DuplicateLimit is generous enough to
+// let every call through on its own.
+func TestSamplingAndDedupeCompose(t *testing.T) {
+	observerCore, logs := observer.New(zapcore.InfoLevel)
+
+	policy := &SamplingPolicy{
+		Initial:        1,
+		Thereafter:     0,
+		Tick:           time.Minute,
+		DuplicateLimit: 10,
+	}
+
+	core := dedupedCore(observerCore, policy)
+	core = sampledCore(core, policy)
+
+	l := zap.New(core)
+	for i := 0; i < 5; i++ {
+		l.Info("repeated message", zap.String("key", "value"))
+	}
+
+	if got := logs.Len(); got != 1 {
+		t.Fatalf("expected the sampler to cap identical log lines at Initial=1, got %d entries", got)
+	}
+}
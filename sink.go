@@ -0,0 +1,56 @@
+package logger
+
+import (
+	"io"
+
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// RotationPolicy configures log file rotation for a file output.
+type RotationPolicy struct {
+	MaxSizeMB  int
+	MaxAgeDays int
+	MaxBackups int
+	Compress   bool
+}
+
+// Option configures an Instance beyond what Config exposes.
+type Option func(*options)
+
+type options struct {
+	writers []io.Writer
+}
+
+// WithWriter adds an additional destination (e.g. Kafka, syslog) that every
+// log line is written to alongside the configured stdout/stderr and file
+// outputs.
+func WithWriter(w io.Writer) Option {
+	return func(o *options) {
+		o.writers = append(o.writers, w)
+	}
+}
+
+// fileCore builds a zapcore.Core that writes to path, rotating according to
+// policy when one is provided and by lumberjack's defaults otherwise.
+func fileCore(path string, policy *RotationPolicy, enc zapcore.Encoder, level zapcore.LevelEnabler) zapcore.Core {
+	var w zapcore.WriteSyncer
+	if policy != nil {
+		w = zapcore.AddSync(&lumberjack.Logger{
+			Filename:   path,
+			MaxSize:    policy.MaxSizeMB,
+			MaxAge:     policy.MaxAgeDays,
+			MaxBackups: policy.MaxBackups,
+			Compress:   policy.Compress,
+		})
+	} else {
+		w = zapcore.AddSync(&lumberjack.Logger{Filename: path})
+	}
+
+	return zapcore.NewCore(enc, w, level)
+}
+
+// writerCore builds a zapcore.Core over an arbitrary io.Writer.
+func writerCore(w io.Writer, enc zapcore.Encoder, level zapcore.LevelEnabler) zapcore.Core {
+	return zapcore.NewCore(enc, zapcore.AddSync(w), level)
+}
@@ -0,0 +1,165 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// slogHandler adapts an Instance to the log/slog.Handler interface so
+// callers can use slog as their logging API while keeping this package's
+// configuration and sinks underneath.
+type slogHandler struct {
+	logger *zap.Logger
+}
+
+// SlogHandler returns a slog.Handler backed by li, suitable for
+// slog.New(li.SlogHandler()).
+func (li *Instance) SlogHandler() slog.Handler {
+	return &slogHandler{logger: li.logger}
+}
+
+func (h *slogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return h.logger.Core().Enabled(slogToZapLevel(level))
+}
+
+func (h *slogHandler) Handle(_ context.Context, record slog.Record) error {
+	fields := make([]zap.Field, 0, record.NumAttrs())
+	record.Attrs(func(attr slog.Attr) bool {
+		fields = append(fields, slogAttrToZapFields(attr)...)
+		return true
+	})
+
+	if ce := h.logger.Check(slogToZapLevel(record.Level), record.Message); ce != nil {
+		ce.Time = record.Time
+		ce.Write(fields...)
+	}
+
+	return nil
+}
+
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	fields := make([]zap.Field, 0, len(attrs))
+	for _, attr := range attrs {
+		fields = append(fields, slogAttrToZapFields(attr)...)
+	}
+
+	return &slogHandler{logger: h.logger.With(fields...)}
+}
+
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	return &slogHandler{logger: h.logger.With(zap.Namespace(name))}
+}
+
+func slogToZapLevel(level slog.Level) zapcore.Level {
+	switch {
+	case level >= slog.LevelError:
+		return zapcore.ErrorLevel
+	case level >= slog.LevelWarn:
+		return zapcore.WarnLevel
+	case level >= slog.LevelInfo:
+		return zapcore.InfoLevel
+	default:
+		return zapcore.DebugLevel
+	}
+}
+
+// slogAttrToZapFields converts a single slog.Attr into one or more
+// zap.Field, resolving slog.LogValuer values and nested groups. It
+// normally returns exactly one field, except for the error+LogValuer case
+// handled by errAwareLogValuerFields, which can return two.
+func slogAttrToZapFields(attr slog.Attr) []zap.Field {
+	return slogValueToZapFields(attr.Key, attr.Value)
+}
+
+func slogValueToZapFields(key string, value slog.Value) []zap.Field {
+	// A LogValuer value must be inspected before Resolve() unwraps it:
+	// Resolve() repeatedly calls LogValue() until the result is no
+	// longer a LogValuer, which would erase the error+LogValuer case
+	// below before we ever see it.
+	if value.Kind() == slog.KindLogValuer {
+		if fields, ok := errAwareLogValuerFields(key, value.Any()); ok {
+			return fields
+		}
+	}
+
+	value = value.Resolve()
+
+	switch value.Kind() {
+	case slog.KindGroup:
+		fields := make([]zap.Field, 0, len(value.Group()))
+		for _, attr := range value.Group() {
+			fields = append(fields, slogAttrToZapFields(attr)...)
+		}
+		return []zap.Field{zap.Object(key, zapGroupObject(fields))}
+	case slog.KindString:
+		return []zap.Field{zap.String(key, value.String())}
+	case slog.KindInt64:
+		return []zap.Field{zap.Int64(key, value.Int64())}
+	case slog.KindUint64:
+		return []zap.Field{zap.Uint64(key, value.Uint64())}
+	case slog.KindFloat64:
+		return []zap.Field{zap.Float64(key, value.Float64())}
+	case slog.KindBool:
+		return []zap.Field{zap.Bool(key, value.Bool())}
+	case slog.KindDuration:
+		return []zap.Field{zap.Duration(key, value.Duration())}
+	case slog.KindTime:
+		return []zap.Field{zap.Time(key, value.Time())}
+	default:
+		return []zap.Field{zap.Any(key, value.Any())}
+	}
+}
+
+// errAwareLogValuerFields handles the case where a not-yet-resolved
+// slog.LogValuer attribute's underlying value also implements error: the
+// error message is logged under key, and a sibling "<key>Details" field
+// carries the once-resolved value. ok is false when raw isn't both an
+// error and a LogValuer, in which case the caller should resolve it the
+// ordinary way instead.
+func errAwareLogValuerFields(key string, raw interface{}) (fields []zap.Field, ok bool) {
+	err, isErr := raw.(error)
+	valuer, isValuer := raw.(slog.LogValuer)
+
+	if !isErr || !isValuer {
+		return nil, false
+	}
+
+	resolved := valuer.LogValue().Resolve().Any()
+	if sameError(resolved, err) {
+		return []zap.Field{zap.String(key, err.Error())}, true
+	}
+
+	return []zap.Field{
+		zap.String(key, err.Error()),
+		zap.Any(key+"Details", resolved),
+	}, true
+}
+
+// sameError reports whether resolved is the same error value as err,
+// guarding the equality check against panicking when resolved holds a
+// non-comparable dynamic type (e.g. a map or slice) returned from
+// LogValue().
+func sameError(resolved interface{}, err error) bool {
+	v := reflect.ValueOf(resolved)
+	if !v.IsValid() || !v.Comparable() {
+		return false
+	}
+
+	return resolved == error(err)
+}
+
+// zapGroupObject adapts a flat slice of zap.Field into a
+// zapcore.ObjectMarshaler so it can be nested under a namespace via
+// zap.Object, mirroring slog's nested group semantics.
+type zapGroupObject []zap.Field
+
+func (g zapGroupObject) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for _, f := range g {
+		f.AddTo(enc)
+	}
+	return nil
+}
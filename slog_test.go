@@ -0,0 +1,39 @@
+package logger
+
+import (
+	"log/slog"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// errLogValuer is an error whose LogValue resolves to something other
+// than itself, exercising the <key>Details sibling field.
+type errLogValuer struct{ msg string }
+
+func (e errLogValuer) Error() string { return e.msg }
+
+func (e errLogValuer) LogValue() slog.Value {
+	return slog.StringValue("resolved:" + e.msg)
+}
+
+func TestSlogHandlerErrorLogValuer(t *testing.T) {
+	observerCore, logs := observer.New(zapcore.InfoLevel)
+	li := &Instance{logger: zap.New(observerCore)}
+
+	slog.New(li.SlogHandler()).Error("failed", "err", errLogValuer{msg: "kaboom"})
+
+	if got := logs.Len(); got != 1 {
+		t.Fatalf("expected 1 log entry, got %d", got)
+	}
+
+	fields := logs.All()[0].ContextMap()
+	if got, want := fields["err"], "kaboom"; got != want {
+		t.Fatalf("err field = %v, want %v", got, want)
+	}
+	if got, want := fields["errDetails"], "resolved:kaboom"; got != want {
+		t.Fatalf("errDetails field = %v, want %v", got, want)
+	}
+}